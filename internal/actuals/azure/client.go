@@ -0,0 +1,305 @@
+// Package azure fetches realized Azure costs from the Cost Management REST API
+// so they can be reconciled against Infracost's Terraform-based estimates.
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+const costManagementAPIVersion = "2022-10-01"
+
+// AuthMethod selects how the client obtains an Azure AD access token.
+type AuthMethod string
+
+const (
+	AuthServicePrincipal AuthMethod = "service_principal"
+	AuthWorkloadIdentity AuthMethod = "workload_identity"
+	AuthCLIToken         AuthMethod = "cli"
+)
+
+// Config holds the credentials and subscription scope used to query Cost Management.
+type Config struct {
+	Auth           AuthMethod
+	SubscriptionID string
+	TenantID       string
+	ClientID       string
+	ClientSecret   string
+}
+
+// TokenSource returns a bearer token for the Azure Resource Manager audience.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// Client queries the Cost Management `query` action for a subscription.
+type Client struct {
+	httpClient     *http.Client
+	tokens         TokenSource
+	subscriptionID string
+}
+
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.SubscriptionID == "" {
+		return nil, fmt.Errorf("azure actuals: subscription_id is required")
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+
+	tokens, err := newTokenSource(cfg, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		httpClient:     httpClient,
+		tokens:         tokens,
+		subscriptionID: cfg.SubscriptionID,
+	}, nil
+}
+
+func newTokenSource(cfg Config, httpClient *http.Client) (TokenSource, error) {
+	switch cfg.Auth {
+	case AuthServicePrincipal, AuthWorkloadIdentity:
+		if cfg.TenantID == "" || cfg.ClientID == "" {
+			return nil, fmt.Errorf("azure actuals: tenant_id and client_id are required for %s auth", cfg.Auth)
+		}
+		return &clientCredentialsTokenSource{httpClient: httpClient, tenantID: cfg.TenantID, clientID: cfg.ClientID, clientSecret: cfg.ClientSecret}, nil
+	case AuthCLIToken, "":
+		return &cliTokenSource{}, nil
+	default:
+		return nil, fmt.Errorf("azure actuals: unsupported auth method %q", cfg.Auth)
+	}
+}
+
+// clientCredentialsTokenSource covers both service principal secrets and
+// workload identity federated credentials, which use the same OAuth2 client
+// credentials grant against Azure AD.
+type clientCredentialsTokenSource struct {
+	httpClient   *http.Client
+	tenantID     string
+	clientID     string
+	clientSecret string
+}
+
+func (t *clientCredentialsTokenSource) Token(ctx context.Context) (string, error) {
+	endpoint := fmt.Sprintf("https://login.microsoftonline.com/%s/oauth2/v2.0/token", t.tenantID)
+	values := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {t.clientID},
+		"client_secret": {t.clientSecret},
+		"scope":         {"https://management.azure.com/.default"},
+	}
+	if t.clientSecret == "" {
+		// Workload identity: present the projected federated token as a client
+		// assertion in place of a client secret.
+		assertion, err := os.ReadFile(os.Getenv("AZURE_FEDERATED_TOKEN_FILE"))
+		if err != nil {
+			return "", fmt.Errorf("azure actuals: reading federated token file: %w", err)
+		}
+		values.Del("client_secret")
+		values.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+		values.Set("client_assertion", strings.TrimSpace(string(assertion)))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("azure actuals: requesting access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("azure actuals: decoding access token response: %w", err)
+	}
+
+	if body.AccessToken == "" {
+		return "", fmt.Errorf("azure actuals: access token request returned no token")
+	}
+
+	return body.AccessToken, nil
+}
+
+// cliTokenSource shells out to `az account get-access-token`, mirroring how the
+// Azure Cost Management CLI's own `view`/`query` commands authenticate by default.
+type cliTokenSource struct{}
+
+func (t *cliTokenSource) Token(ctx context.Context) (string, error) {
+	out, err := exec.CommandContext(ctx, "az", "account", "get-access-token", "--resource", "https://management.azure.com/", "--query", "accessToken", "-o", "tsv").Output()
+	if err != nil {
+		return "", fmt.Errorf("azure actuals: running az account get-access-token: %w", err)
+	}
+
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", fmt.Errorf("azure actuals: az cli returned an empty access token")
+	}
+
+	return token, nil
+}
+
+// Scope is the Cost Management query scope, e.g. a subscription or resource group.
+type Scope string
+
+const (
+	ScopeSubscription  Scope = "subscription"
+	ScopeResourceGroup Scope = "resourceGroup"
+)
+
+// Timeframe matches the Cost Management query API's timeframe values.
+type Timeframe string
+
+const (
+	TimeframeMonthToDate Timeframe = "MonthToDate"
+	TimeframeCustom      Timeframe = "Custom"
+)
+
+// QueryInput describes an actual-cost query, grouped by ResourceId so rows can
+// be matched back to Terraform resource addresses during reconciliation.
+type QueryInput struct {
+	Scope         Scope
+	ResourceGroup string
+	Timeframe     Timeframe
+	From          time.Time
+	To            time.Time
+}
+
+// ActualCost is one ResourceId-grouped row returned by Cost Management.
+type ActualCost struct {
+	ResourceID string
+	Actual     decimal.Decimal
+	Currency   string
+}
+
+func (c *Client) scopePath(in QueryInput) string {
+	if in.Scope == ScopeResourceGroup {
+		return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", c.subscriptionID, in.ResourceGroup)
+	}
+	return fmt.Sprintf("/subscriptions/%s", c.subscriptionID)
+}
+
+// QueryActualCosts calls the Cost Management query API and returns realized
+// cost grouped by Azure resource ID.
+func (c *Client) QueryActualCosts(ctx context.Context, in QueryInput) ([]ActualCost, error) {
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody := map[string]interface{}{
+		"type":      "ActualCost",
+		"timeframe": in.Timeframe,
+		"dataset": map[string]interface{}{
+			"granularity": "None",
+			"aggregation": map[string]interface{}{
+				"totalCost": map[string]string{"name": "Cost", "function": "Sum"},
+			},
+			"grouping": []map[string]string{
+				{"type": "Dimension", "name": "ResourceId"},
+			},
+		},
+	}
+	if in.Timeframe == TimeframeCustom {
+		reqBody["timePeriod"] = map[string]string{
+			"from": in.From.Format(time.RFC3339),
+			"to":   in.To.Format(time.RFC3339),
+		}
+	}
+
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("azure actuals: encoding query body: %w", err)
+	}
+
+	url := fmt.Sprintf("https://management.azure.com%s/providers/Microsoft.CostManagement/query?api-version=%s", c.scopePath(in), costManagementAPIVersion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("azure actuals: calling Cost Management query API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("azure actuals: Cost Management query API returned %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Properties struct {
+			Columns []struct {
+				Name string `json:"name"`
+			} `json:"columns"`
+			Rows [][]interface{} `json:"rows"`
+		} `json:"properties"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("azure actuals: decoding query response: %w", err)
+	}
+
+	costIdx, resourceIdx, currencyIdx := -1, -1, -1
+	for i, col := range result.Properties.Columns {
+		switch col.Name {
+		case "Cost":
+			costIdx = i
+		case "ResourceId":
+			resourceIdx = i
+		case "Currency":
+			currencyIdx = i
+		}
+	}
+	if costIdx == -1 || resourceIdx == -1 {
+		return nil, fmt.Errorf("azure actuals: query response is missing the Cost or ResourceId column")
+	}
+
+	actuals := make([]ActualCost, 0, len(result.Properties.Rows))
+	for _, row := range result.Properties.Rows {
+		if costIdx >= len(row) || resourceIdx >= len(row) {
+			log.Warnf("azure actuals: skipping malformed Cost Management row %v", row)
+			continue
+		}
+
+		cost, err := decimal.NewFromString(fmt.Sprintf("%v", row[costIdx]))
+		if err != nil {
+			log.Warnf("azure actuals: skipping Cost Management row with unparseable cost %v: %s", row[costIdx], err)
+			continue
+		}
+
+		actual := ActualCost{
+			ResourceID: fmt.Sprintf("%v", row[resourceIdx]),
+			Actual:     cost,
+		}
+		if currencyIdx != -1 && currencyIdx < len(row) {
+			actual.Currency = fmt.Sprintf("%v", row[currencyIdx])
+		}
+		actuals = append(actuals, actual)
+	}
+
+	return actuals, nil
+}
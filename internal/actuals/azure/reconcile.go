@@ -0,0 +1,68 @@
+package azure
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// Divergence is the result of comparing one Terraform resource's Infracost
+// estimate against its realized Azure spend.
+type Divergence struct {
+	ResourceAddress string
+	ResourceID      string
+	Estimated       decimal.Decimal
+	Actual          decimal.Decimal
+	DivergencePct   decimal.Decimal
+}
+
+// Reconcile matches actual costs to Terraform resource addresses via
+// resourceIDsByAddress and flags any whose divergence from the estimate
+// exceeds thresholdPct (e.g. decimal.NewFromInt(20) for 20%).
+//
+// Wiring this into the CLI (--azure-actuals flags) and into schema.Resource's
+// estimated quantities is left to the output layer; this package only does
+// the Cost Management lookup and the percentage comparison.
+func Reconcile(estimates map[string]decimal.Decimal, actuals []ActualCost, resourceIDsByAddress map[string]string, thresholdPct decimal.Decimal) []Divergence {
+	actualByID := make(map[string]decimal.Decimal, len(actuals))
+	for _, a := range actuals {
+		actualByID[a.ResourceID] = a.Actual
+	}
+
+	var divergences []Divergence
+	for address, resourceID := range resourceIDsByAddress {
+		estimated, ok := estimates[address]
+		if !ok {
+			continue
+		}
+
+		actual, ok := actualByID[resourceID]
+		if !ok {
+			continue
+		}
+
+		pct := divergencePct(estimated, actual)
+		if !pct.Abs().GreaterThan(thresholdPct) {
+			continue
+		}
+
+		divergences = append(divergences, Divergence{
+			ResourceAddress: address,
+			ResourceID:      resourceID,
+			Estimated:       estimated,
+			Actual:          actual,
+			DivergencePct:   pct,
+		})
+	}
+
+	return divergences
+}
+
+func divergencePct(estimated, actual decimal.Decimal) decimal.Decimal {
+	if estimated.IsZero() {
+		if actual.IsZero() {
+			return decimal.Zero
+		}
+		return decimal.NewFromInt(100)
+	}
+
+	return actual.Sub(estimated).Div(estimated).Mul(decimal.NewFromInt(100))
+}
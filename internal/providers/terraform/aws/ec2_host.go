@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/infracost/infracost/internal/schema"
+
+	"github.com/shopspring/decimal"
+)
+
+func GetEC2HostRegistryItem() *schema.RegistryItem {
+	return &schema.RegistryItem{
+		Name: "aws_ec2_host",
+		Notes: []string{
+			"auto_placement and host_recovery do not affect the Dedicated Host price.",
+		},
+		RFunc: NewEC2Host,
+	}
+}
+
+func NewEC2Host(d *schema.ResourceData, u *schema.UsageData) *schema.Resource {
+	region := d.Get("region").String()
+
+	// instance_family and instance_type are mutually exclusive on aws_ec2_host;
+	// derive the family from whichever one is set.
+	instanceFamily := d.Get("instance_family").String()
+	if instanceFamily == "" && d.Get("instance_type").Exists() {
+		instanceFamily = strings.SplitN(d.Get("instance_type").String(), ".", 2)[0]
+	}
+
+	hostCount := decimal.NewFromInt(1)
+
+	costComponents := []*schema.CostComponent{ec2HostCostComponent(region, instanceFamily, hostCount, u)}
+
+	return &schema.Resource{
+		Name:           d.Address,
+		CostComponents: costComponents,
+	}
+}
+
+func ec2HostCostComponent(region, instanceFamily string, hostCount decimal.Decimal, u *schema.UsageData) *schema.CostComponent {
+	priceFilter := &schema.PriceFilter{}
+	if u != nil && u.Get("reserved_instance_term").Exists() {
+		reservedTermName, reservedPaymentOptionName := reservedTermNames(u.Get("reserved_instance_term").String(), u.Get("reserved_instance_payment_option").String())
+		priceFilter.StartUsageAmount = strPtr("0")
+		priceFilter.TermLength = &reservedTermName
+		priceFilter.TermPurchaseOption = &reservedPaymentOptionName
+	}
+
+	return &schema.CostComponent{
+		Name:           fmt.Sprintf("Dedicated host usage (%s family)", instanceFamily),
+		Unit:           "hours",
+		UnitMultiplier: 1,
+		HourlyQuantity: decimalPtr(hostCount),
+		ProductFilter: &schema.ProductFilter{
+			VendorName:    strPtr("aws"),
+			Region:        strPtr(region),
+			Service:       strPtr("AmazonEC2"),
+			ProductFamily: strPtr("Dedicated Host"),
+			AttributeFilters: []*schema.AttributeFilter{
+				{Key: "tenancy", Value: strPtr("Host")},
+				{Key: "instanceFamily", Value: strPtr(instanceFamily)},
+			},
+		},
+		PriceFilter: priceFilter,
+	}
+}
